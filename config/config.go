@@ -0,0 +1,89 @@
+// Copyright 2025 zampo.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// @contact  zampo3380@gmail.com
+
+// Package config 解析 OpenTelemetry 声明式配置 schema 中与 trace 相关的子集
+// （tracer_provider / processors / exporters / samplers / propagators / resource），
+// 让运维可以通过 YAML/JSON 调整采样率、增加 exporter 或切换传播器而无需重新部署
+package config
+
+// SDKConfig 是声明式配置反序列化后的结构
+type SDKConfig struct {
+	Resource       ResourceConfig       `yaml:"resource" json:"resource"`
+	TracerProvider TracerProviderConfig `yaml:"tracer_provider" json:"tracer_provider"`
+	Propagators    []string             `yaml:"propagators" json:"propagators"`
+}
+
+// ResourceConfig 对应 schema 中的 resource 节点
+type ResourceConfig struct {
+	Attributes map[string]string `yaml:"attributes" json:"attributes"`
+}
+
+// TracerProviderConfig 对应 schema 中的 tracer_provider 节点
+type TracerProviderConfig struct {
+	Sampler    *SamplerConfig    `yaml:"sampler,omitempty" json:"sampler,omitempty"`
+	Processors []ProcessorConfig `yaml:"processors" json:"processors"`
+}
+
+// SamplerConfig 对应 schema 中的 samplers 节点，同一时间应只设置其中一个字段
+type SamplerConfig struct {
+	AlwaysOn     *struct{}           `yaml:"always_on,omitempty" json:"always_on,omitempty"`
+	AlwaysOff    *struct{}           `yaml:"always_off,omitempty" json:"always_off,omitempty"`
+	TraceIDRatio *TraceIDRatioConfig `yaml:"trace_id_ratio_based,omitempty" json:"trace_id_ratio_based,omitempty"`
+	ParentBased  *ParentBasedConfig  `yaml:"parent_based,omitempty" json:"parent_based,omitempty"`
+}
+
+// TraceIDRatioConfig 对应 trace_id_ratio_based 采样器
+type TraceIDRatioConfig struct {
+	Ratio float64 `yaml:"ratio" json:"ratio"`
+}
+
+// ParentBasedConfig 对应 parent_based 采样器，Root 缺省时根 span 按 always_on 采样
+type ParentBasedConfig struct {
+	Root *SamplerConfig `yaml:"root,omitempty" json:"root,omitempty"`
+}
+
+// ProcessorConfig 对应 schema 中 processors 列表的单个元素，同一时间应只设置其中一个字段
+type ProcessorConfig struct {
+	Batch *BatchProcessorConfig `yaml:"batch,omitempty" json:"batch,omitempty"`
+}
+
+// BatchProcessorConfig 对应 batch 处理器
+type BatchProcessorConfig struct {
+	Exporter           ExporterConfig `yaml:"exporter" json:"exporter"`
+	ScheduleDelayMS    int            `yaml:"schedule_delay,omitempty" json:"schedule_delay,omitempty"`
+	MaxQueueSize       int            `yaml:"max_queue_size,omitempty" json:"max_queue_size,omitempty"`
+	MaxExportBatchSize int            `yaml:"max_export_batch_size,omitempty" json:"max_export_batch_size,omitempty"`
+}
+
+// ExporterConfig 对应 schema 中 exporters 节点的单个元素，同一时间应只设置其中一个字段
+type ExporterConfig struct {
+	OTLPHTTP *OTLPExporterConfig   `yaml:"otlp_http,omitempty" json:"otlp_http,omitempty"`
+	OTLPGRPC *OTLPExporterConfig   `yaml:"otlp_grpc,omitempty" json:"otlp_grpc,omitempty"`
+	Console  *struct{}             `yaml:"console,omitempty" json:"console,omitempty"`
+	Zipkin   *ZipkinExporterConfig `yaml:"zipkin,omitempty" json:"zipkin,omitempty"`
+}
+
+// OTLPExporterConfig 对应 otlp_http / otlp_grpc exporter
+type OTLPExporterConfig struct {
+	Endpoint string            `yaml:"endpoint" json:"endpoint"`
+	Headers  map[string]string `yaml:"headers,omitempty" json:"headers,omitempty"`
+	Insecure bool              `yaml:"insecure,omitempty" json:"insecure,omitempty"`
+}
+
+// ZipkinExporterConfig 对应 zipkin exporter
+type ZipkinExporterConfig struct {
+	Endpoint string `yaml:"endpoint" json:"endpoint"`
+}
@@ -0,0 +1,42 @@
+// Copyright 2025 zampo.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// @contact  zampo3380@gmail.com
+
+package config
+
+import (
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// LoadFromYAML 解析声明式配置；JSON 是 YAML 的子集，同一个解析器可以直接处理两种格式
+func LoadFromYAML(data []byte) (SDKConfig, error) {
+	var cfg SDKConfig
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return SDKConfig{}, fmt.Errorf("parse sdk config: %w", err)
+	}
+	return cfg, nil
+}
+
+// LoadFromFile 从磁盘读取并解析声明式配置文件（.yaml/.yml/.json 均可）
+func LoadFromFile(path string) (SDKConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return SDKConfig{}, fmt.Errorf("read sdk config file %q: %w", path, err)
+	}
+	return LoadFromYAML(data)
+}
@@ -0,0 +1,55 @@
+// Copyright 2025 zampo.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// @contact  zampo3380@gmail.com
+
+package config
+
+import (
+	"fmt"
+	"sync"
+
+	"go.opentelemetry.io/otel/propagation"
+)
+
+var (
+	propagatorsMu sync.RWMutex
+	propagators   = map[string]propagation.TextMapPropagator{
+		"tracecontext": propagation.TraceContext{},
+		"baggage":      propagation.Baggage{},
+	}
+)
+
+// RegisterPropagator 注册一个可在声明式配置的 propagators 列表里按名字引用的
+// propagator，用于接入 B3、Jaeger 等 contrib propagator 而无需本包直接依赖它们
+func RegisterPropagator(name string, p propagation.TextMapPropagator) {
+	propagatorsMu.Lock()
+	defer propagatorsMu.Unlock()
+	propagators[name] = p
+}
+
+func buildPropagator(names []string) (propagation.TextMapPropagator, error) {
+	propagatorsMu.RLock()
+	defer propagatorsMu.RUnlock()
+
+	resolved := make([]propagation.TextMapPropagator, 0, len(names))
+	for _, name := range names {
+		p, ok := propagators[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown propagator %q, register it via RegisterPropagator", name)
+		}
+		resolved = append(resolved, p)
+	}
+	return propagation.NewCompositeTextMapPropagator(resolved...), nil
+}
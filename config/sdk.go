@@ -0,0 +1,172 @@
+// Copyright 2025 zampo.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// @contact  zampo3380@gmail.com
+
+package config
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/exporters/zipkin"
+	"go.opentelemetry.io/otel/sdk/resource"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// SDK 是声明式配置解析后的产物，持有一个独立的 TracerProvider；
+// 它不会触碰全局的 otel.SetTracerProvider，由调用方决定是否设为全局
+type SDK struct {
+	tp *tracesdk.TracerProvider
+}
+
+// TracerProvider 返回该 SDK 持有的 TracerProvider
+func (s *SDK) TracerProvider() trace.TracerProvider {
+	return s.tp
+}
+
+// Shutdown 关闭 TracerProvider 及其下属的所有 SpanProcessor
+func (s *SDK) Shutdown(ctx context.Context) error {
+	return s.tp.Shutdown(ctx)
+}
+
+// NewSDK 根据声明式配置构建一个 TracerProvider。
+// 如果配置了 propagators，同时会设置全局 TextMapPropagator
+func NewSDK(cfg SDKConfig) (*SDK, error) {
+	res, err := buildResource(cfg.Resource)
+	if err != nil {
+		return nil, err
+	}
+
+	tpOpts := []tracesdk.TracerProviderOption{tracesdk.WithResource(res)}
+
+	if cfg.TracerProvider.Sampler != nil {
+		s, err := buildSampler(*cfg.TracerProvider.Sampler)
+		if err != nil {
+			return nil, err
+		}
+		tpOpts = append(tpOpts, tracesdk.WithSampler(s))
+	}
+
+	for i, p := range cfg.TracerProvider.Processors {
+		proc, err := buildProcessor(p)
+		if err != nil {
+			return nil, fmt.Errorf("processors[%d]: %w", i, err)
+		}
+		tpOpts = append(tpOpts, tracesdk.WithSpanProcessor(proc))
+	}
+
+	tp := tracesdk.NewTracerProvider(tpOpts...)
+
+	if len(cfg.Propagators) > 0 {
+		propagator, err := buildPropagator(cfg.Propagators)
+		if err != nil {
+			return nil, err
+		}
+		otel.SetTextMapPropagator(propagator)
+	}
+
+	return &SDK{tp: tp}, nil
+}
+
+func buildResource(cfg ResourceConfig) (*resource.Resource, error) {
+	attrs := make([]attribute.KeyValue, 0, len(cfg.Attributes))
+	for k, v := range cfg.Attributes {
+		attrs = append(attrs, attribute.String(k, v))
+	}
+	return resource.NewWithAttributes(semconv.SchemaURL, attrs...), nil
+}
+
+func buildSampler(cfg SamplerConfig) (tracesdk.Sampler, error) {
+	switch {
+	case cfg.AlwaysOn != nil:
+		return tracesdk.AlwaysSample(), nil
+	case cfg.AlwaysOff != nil:
+		return tracesdk.NeverSample(), nil
+	case cfg.TraceIDRatio != nil:
+		return tracesdk.TraceIDRatioBased(cfg.TraceIDRatio.Ratio), nil
+	case cfg.ParentBased != nil:
+		root := tracesdk.Sampler(tracesdk.AlwaysSample())
+		if cfg.ParentBased.Root != nil {
+			r, err := buildSampler(*cfg.ParentBased.Root)
+			if err != nil {
+				return nil, fmt.Errorf("parent_based.root: %w", err)
+			}
+			root = r
+		}
+		return tracesdk.ParentBased(root), nil
+	default:
+		return nil, fmt.Errorf("sampler config must set one of always_on/always_off/trace_id_ratio_based/parent_based")
+	}
+}
+
+func buildProcessor(cfg ProcessorConfig) (tracesdk.SpanProcessor, error) {
+	if cfg.Batch == nil {
+		return nil, fmt.Errorf("processor config must set batch")
+	}
+
+	exp, err := buildExporter(cfg.Batch.Exporter)
+	if err != nil {
+		return nil, fmt.Errorf("batch.exporter: %w", err)
+	}
+
+	var opts []tracesdk.BatchSpanProcessorOption
+	if cfg.Batch.MaxQueueSize > 0 {
+		opts = append(opts, tracesdk.WithMaxQueueSize(cfg.Batch.MaxQueueSize))
+	}
+	if cfg.Batch.MaxExportBatchSize > 0 {
+		opts = append(opts, tracesdk.WithMaxExportBatchSize(cfg.Batch.MaxExportBatchSize))
+	}
+	if cfg.Batch.ScheduleDelayMS > 0 {
+		opts = append(opts, tracesdk.WithBatchTimeout(time.Duration(cfg.Batch.ScheduleDelayMS)*time.Millisecond))
+	}
+	return tracesdk.NewBatchSpanProcessor(exp, opts...), nil
+}
+
+func buildExporter(cfg ExporterConfig) (tracesdk.SpanExporter, error) {
+	switch {
+	case cfg.OTLPHTTP != nil:
+		opts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(cfg.OTLPHTTP.Endpoint)}
+		if len(cfg.OTLPHTTP.Headers) > 0 {
+			opts = append(opts, otlptracehttp.WithHeaders(cfg.OTLPHTTP.Headers))
+		}
+		if cfg.OTLPHTTP.Insecure {
+			opts = append(opts, otlptracehttp.WithInsecure())
+		}
+		return otlptracehttp.New(context.Background(), opts...)
+	case cfg.OTLPGRPC != nil:
+		opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(cfg.OTLPGRPC.Endpoint)}
+		if len(cfg.OTLPGRPC.Headers) > 0 {
+			opts = append(opts, otlptracegrpc.WithHeaders(cfg.OTLPGRPC.Headers))
+		}
+		if cfg.OTLPGRPC.Insecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		return otlptracegrpc.New(context.Background(), opts...)
+	case cfg.Console != nil:
+		return stdouttrace.New(stdouttrace.WithPrettyPrint())
+	case cfg.Zipkin != nil:
+		return zipkin.New(cfg.Zipkin.Endpoint)
+	default:
+		return nil, fmt.Errorf("exporter config must set one of otlp_http/otlp_grpc/console/zipkin")
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright 2025 zampo.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"context"
+	"testing"
+)
+
+const sampleYAML = `
+resource:
+  attributes:
+    service.name: checkout
+tracer_provider:
+  sampler:
+    trace_id_ratio_based:
+      ratio: 0.5
+  processors:
+    - batch:
+        exporter:
+          console: {}
+propagators:
+  - tracecontext
+  - baggage
+`
+
+func TestLoadFromYAML(t *testing.T) {
+	cfg, err := LoadFromYAML([]byte(sampleYAML))
+	if err != nil {
+		t.Fatalf("LoadFromYAML() unexpected error: %v", err)
+	}
+	if cfg.Resource.Attributes["service.name"] != "checkout" {
+		t.Errorf("Resource.Attributes[service.name] = %q, want %q", cfg.Resource.Attributes["service.name"], "checkout")
+	}
+	if cfg.TracerProvider.Sampler == nil || cfg.TracerProvider.Sampler.TraceIDRatio == nil {
+		t.Fatal("TracerProvider.Sampler.TraceIDRatio not parsed")
+	}
+	if cfg.TracerProvider.Sampler.TraceIDRatio.Ratio != 0.5 {
+		t.Errorf("Sampler.TraceIDRatio.Ratio = %v, want 0.5", cfg.TracerProvider.Sampler.TraceIDRatio.Ratio)
+	}
+	if len(cfg.TracerProvider.Processors) != 1 || cfg.TracerProvider.Processors[0].Batch == nil {
+		t.Fatal("TracerProvider.Processors not parsed")
+	}
+	if len(cfg.Propagators) != 2 {
+		t.Errorf("Propagators = %v, want 2 entries", cfg.Propagators)
+	}
+}
+
+func TestLoadFromFile_MissingFile(t *testing.T) {
+	if _, err := LoadFromFile("/nonexistent/sdk-config.yaml"); err == nil {
+		t.Error("LoadFromFile() expected error for missing file")
+	}
+}
+
+func TestNewSDK_ConsoleExporter(t *testing.T) {
+	cfg, err := LoadFromYAML([]byte(sampleYAML))
+	if err != nil {
+		t.Fatalf("LoadFromYAML() unexpected error: %v", err)
+	}
+
+	sdk, err := NewSDK(cfg)
+	if err != nil {
+		t.Fatalf("NewSDK() unexpected error: %v", err)
+	}
+	if sdk.TracerProvider() == nil {
+		t.Error("SDK.TracerProvider() returned nil")
+	}
+	if err := sdk.Shutdown(context.Background()); err != nil {
+		t.Errorf("SDK.Shutdown() unexpected error: %v", err)
+	}
+}
+
+func TestBuildSampler_RequiresOneVariant(t *testing.T) {
+	if _, err := buildSampler(SamplerConfig{}); err == nil {
+		t.Error("buildSampler() expected error when no variant is set")
+	}
+}
+
+func TestBuildExporter_RequiresOneVariant(t *testing.T) {
+	if _, err := buildExporter(ExporterConfig{}); err == nil {
+		t.Error("buildExporter() expected error when no variant is set")
+	}
+}
+
+func TestBuildPropagator_UnknownName(t *testing.T) {
+	if _, err := buildPropagator([]string{"b3"}); err == nil {
+		t.Error("buildPropagator() expected error for unregistered propagator")
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright 2025 zampo.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// @contact  zampo3380@gmail.com
+
+package trace
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/exporters/zipkin"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const (
+	envTracesExporter = "OTEL_TRACES_EXPORTER"
+	envOTLPProtocol   = "OTEL_EXPORTER_OTLP_PROTOCOL"
+
+	// ExporterOTLPHTTP OTLP/HTTP exporter，默认选项
+	ExporterOTLPHTTP = "otlphttp"
+	// ExporterOTLPGRPC OTLP/gRPC exporter
+	ExporterOTLPGRPC = "otlpgrpc"
+	// ExporterStdout 将 span 打印到 stdout，便于本地调试
+	ExporterStdout = "stdout"
+	// ExporterZipkin Zipkin exporter
+	ExporterZipkin = "zipkin"
+	// ExporterNoop 空导出器，丢弃所有 span
+	ExporterNoop = "noop"
+)
+
+// SpanExporterFactory 根据配置创建一个 SpanExporter
+// 下游服务可以通过 RegisterExporter 注册自己的工厂（例如 Google Cloud Trace、Datadog），
+// 无需 fork 本仓库
+type SpanExporterFactory func(ctx context.Context, cfg Config) (tracesdk.SpanExporter, error)
+
+var (
+	exportersMu sync.RWMutex
+	exporters   = map[string]SpanExporterFactory{}
+)
+
+func init() {
+	RegisterExporter(ExporterOTLPHTTP, newOTLPHTTPExporter)
+	RegisterExporter(ExporterOTLPGRPC, newOTLPGRPCExporter)
+	RegisterExporter(ExporterStdout, newStdoutExporter)
+	RegisterExporter(ExporterZipkin, newZipkinExporter)
+	RegisterExporter(ExporterNoop, newNoopSpanExporter)
+}
+
+// RegisterExporter 注册一个 exporter 工厂，name 对应 Config.ExporterType 或
+// OTEL_TRACES_EXPORTER 的取值。重复调用同一 name 会覆盖之前注册的工厂
+func RegisterExporter(name string, factory SpanExporterFactory) {
+	exportersMu.Lock()
+	defer exportersMu.Unlock()
+	exporters[name] = factory
+}
+
+func lookupExporter(name string) (SpanExporterFactory, bool) {
+	exportersMu.RLock()
+	defer exportersMu.RUnlock()
+	factory, ok := exporters[name]
+	return factory, ok
+}
+
+// resolveExporterType 决定最终使用的 exporter 类型，优先级从高到低:
+//  1. cfg.ExporterType 显式配置
+//  2. OTEL_TRACES_EXPORTER 环境变量
+//  3. OTEL_EXPORTER_OTLP_PROTOCOL 环境变量，推导出 otlp 协议变体
+//  4. 默认 otlphttp，对齐 opentelemetry-go-contrib autoexport 的默认行为
+func resolveExporterType(cfg Config) string {
+	if cfg.ExporterType != "" {
+		return cfg.ExporterType
+	}
+	if v := os.Getenv(envTracesExporter); v != "" {
+		return v
+	}
+	switch os.Getenv(envOTLPProtocol) {
+	case "grpc":
+		return ExporterOTLPGRPC
+	case "http/protobuf", "http/json":
+		return ExporterOTLPHTTP
+	}
+	return ExporterOTLPHTTP
+}
+
+// newOTLPHTTPExporter 创建 OTLP/HTTP exporter
+func newOTLPHTTPExporter(ctx context.Context, cfg Config) (tracesdk.SpanExporter, error) {
+	if cfg.OTLEndpoint == "" {
+		return nil, fmt.Errorf("otlp endpoint is required for the %s exporter", ExporterOTLPHTTP)
+	}
+	opts := []otlptracehttp.Option{
+		otlptracehttp.WithEndpoint(extractHostPort(cfg.OTLEndpoint)),
+	}
+	if len(cfg.OTLPHeaders) > 0 {
+		opts = append(opts, otlptracehttp.WithHeaders(cfg.OTLPHeaders))
+	}
+	if cfg.TLSConfig != nil {
+		opts = append(opts, otlptracehttp.WithTLSClientConfig(cfg.TLSConfig))
+	} else if cfg.Insecure {
+		opts = append(opts, otlptracehttp.WithInsecure())
+	}
+	return otlptracehttp.New(ctx, opts...)
+}
+
+// newOTLPGRPCExporter 创建 OTLP/gRPC exporter
+func newOTLPGRPCExporter(ctx context.Context, cfg Config) (tracesdk.SpanExporter, error) {
+	if cfg.OTLEndpoint == "" {
+		return nil, fmt.Errorf("otlp endpoint is required for the %s exporter", ExporterOTLPGRPC)
+	}
+	opts := []otlptracegrpc.Option{
+		otlptracegrpc.WithEndpoint(extractHostPort(cfg.OTLEndpoint)),
+	}
+	if len(cfg.OTLPHeaders) > 0 {
+		opts = append(opts, otlptracegrpc.WithHeaders(cfg.OTLPHeaders))
+	}
+	if cfg.TLSConfig != nil {
+		opts = append(opts, otlptracegrpc.WithDialOption(
+			grpc.WithTransportCredentials(credentials.NewTLS(cfg.TLSConfig)),
+		))
+	} else if cfg.Insecure {
+		opts = append(opts, otlptracegrpc.WithDialOption(
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		))
+	}
+	return otlptracegrpc.New(ctx, opts...)
+}
+
+// newStdoutExporter 创建将 span 打印到 stdout 的 exporter，便于本地调试
+func newStdoutExporter(ctx context.Context, cfg Config) (tracesdk.SpanExporter, error) {
+	return stdouttrace.New(stdouttrace.WithPrettyPrint())
+}
+
+// newZipkinExporter 创建 Zipkin exporter，cfg.OTLEndpoint 为 Zipkin collector 地址
+func newZipkinExporter(ctx context.Context, cfg Config) (tracesdk.SpanExporter, error) {
+	if cfg.OTLEndpoint == "" {
+		return nil, fmt.Errorf("zipkin endpoint is required")
+	}
+	return zipkin.New(cfg.OTLEndpoint)
+}
+
+// newNoopSpanExporter 创建空导出器，丢弃所有 span
+func newNoopSpanExporter(ctx context.Context, cfg Config) (tracesdk.SpanExporter, error) {
+	return &noopExporter{}, nil
+}
@@ -0,0 +1,105 @@
+// Copyright 2025 zampo.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func TestResolveExporterType_Explicit(t *testing.T) {
+	cfg := Config{ExporterType: ExporterZipkin}
+	if got := resolveExporterType(cfg); got != ExporterZipkin {
+		t.Errorf("resolveExporterType() = %q, want %q", got, ExporterZipkin)
+	}
+}
+
+func TestResolveExporterType_EnvTracesExporter(t *testing.T) {
+	os.Setenv(envTracesExporter, ExporterStdout)
+	defer os.Unsetenv(envTracesExporter)
+
+	if got := resolveExporterType(Config{}); got != ExporterStdout {
+		t.Errorf("resolveExporterType() = %q, want %q", got, ExporterStdout)
+	}
+}
+
+func TestResolveExporterType_EnvOTLPProtocol(t *testing.T) {
+	os.Setenv(envOTLPProtocol, "grpc")
+	defer os.Unsetenv(envOTLPProtocol)
+
+	if got := resolveExporterType(Config{}); got != ExporterOTLPGRPC {
+		t.Errorf("resolveExporterType() = %q, want %q", got, ExporterOTLPGRPC)
+	}
+}
+
+func TestResolveExporterType_Default(t *testing.T) {
+	if got := resolveExporterType(Config{}); got != ExporterOTLPHTTP {
+		t.Errorf("resolveExporterType() = %q, want %q", got, ExporterOTLPHTTP)
+	}
+}
+
+func TestRegisterExporter_CustomFactory(t *testing.T) {
+	called := false
+	RegisterExporter("custom", func(ctx context.Context, cfg Config) (tracesdk.SpanExporter, error) {
+		called = true
+		return &noopExporter{}, nil
+	})
+
+	factory, ok := lookupExporter("custom")
+	if !ok {
+		t.Fatal("lookupExporter() did not find registered custom exporter")
+	}
+	if _, err := factory(context.Background(), Config{}); err != nil {
+		t.Errorf("custom factory returned unexpected error: %v", err)
+	}
+	if !called {
+		t.Error("custom factory was not invoked")
+	}
+}
+
+func TestNewOTLPHTTPExporter_RequiresEndpoint(t *testing.T) {
+	if _, err := newOTLPHTTPExporter(context.Background(), Config{}); err == nil {
+		t.Error("newOTLPHTTPExporter(no endpoint) expected error, got nil")
+	}
+}
+
+func TestNewOTLPGRPCExporter_RequiresEndpoint(t *testing.T) {
+	if _, err := newOTLPGRPCExporter(context.Background(), Config{}); err == nil {
+		t.Error("newOTLPGRPCExporter(no endpoint) expected error, got nil")
+	}
+}
+
+func TestNewStdoutExporter_NoEndpointRequired(t *testing.T) {
+	exp, err := newStdoutExporter(context.Background(), Config{})
+	if err != nil {
+		t.Errorf("newStdoutExporter(no endpoint) unexpected error: %v", err)
+	}
+	if exp == nil {
+		t.Error("newStdoutExporter() returned nil exporter")
+	}
+}
+
+func TestNewNoopSpanExporter(t *testing.T) {
+	exp, err := newNoopSpanExporter(context.Background(), Config{})
+	if err != nil {
+		t.Errorf("newNoopSpanExporter() unexpected error: %v", err)
+	}
+	if exp == nil {
+		t.Error("newNoopSpanExporter() returned nil exporter")
+	}
+}
@@ -0,0 +1,32 @@
+// Copyright 2025 zampo.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// @contact  zampo3380@gmail.com
+
+package trace
+
+import (
+	"context"
+
+	"github.com/go-anyway/framework-trace/logbridge"
+
+	"go.uber.org/zap"
+)
+
+// LoggerFromContext 返回与 ctx 中活跃 span 关联的 logger：自动带上
+// trace_id/span_id 字段，Warn/Error 级别的日志同时作为 span event 记录。
+// 配合 logbridge.NewContext 使用，handler 只需调用一次即可拿到关联好的日志和追踪
+func LoggerFromContext(ctx context.Context) *zap.Logger {
+	return logbridge.WrapLogger(ctx, logbridge.FromContext(ctx))
+}
@@ -0,0 +1,108 @@
+// Copyright 2025 zampo.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// @contact  zampo3380@gmail.com
+
+// Package logbridge 把 framework-log 的 zap.Logger 和当前活跃的 span 串起来：
+// 日志自动带上 trace_id/span_id，Warn/Error 级别的日志同时作为 span event 记录
+package logbridge
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	oteltrace "go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+type ctxKey struct{}
+
+// NewContext 把 logger 绑定到 ctx 上，供 FromContext 取回
+func NewContext(ctx context.Context, logger *zap.Logger) context.Context {
+	return context.WithValue(ctx, ctxKey{}, logger)
+}
+
+// FromContext 取回绑定在 ctx 上的 logger，未绑定时退回全局 logger
+func FromContext(ctx context.Context) *zap.Logger {
+	if l, ok := ctx.Value(ctxKey{}).(*zap.Logger); ok {
+		return l
+	}
+	return zap.L()
+}
+
+// WrapLogger 返回一个绑定了 ctx 中活跃 span 的 logger：每条日志自动带上
+// trace_id/span_id 字段，Warn/Error 级别的日志同时作为 span event 记录，
+// Error 级别还会把 span 标记为 codes.Error
+func WrapLogger(ctx context.Context, base *zap.Logger) *zap.Logger {
+	span := oteltrace.SpanFromContext(ctx)
+
+	var traceID, spanID string
+	if sc := span.SpanContext(); sc.IsValid() {
+		traceID = sc.TraceID().String()
+		spanID = sc.SpanID().String()
+	}
+
+	return base.WithOptions(zap.WrapCore(func(c zapcore.Core) zapcore.Core {
+		return newCore(c, span, traceID, spanID)
+	}))
+}
+
+// core 包装一个 zapcore.Core，在写入时追加 trace_id/span_id 字段，并把
+// Warn/Error 级别的日志镜像为 span event
+type core struct {
+	zapcore.Core
+	span    oteltrace.Span
+	traceID string
+	spanID  string
+}
+
+func newCore(next zapcore.Core, span oteltrace.Span, traceID, spanID string) *core {
+	return &core{Core: next, span: span, traceID: traceID, spanID: spanID}
+}
+
+func (c *core) With(fields []zapcore.Field) zapcore.Core {
+	return &core{Core: c.Core.With(fields), span: c.span, traceID: c.traceID, spanID: c.spanID}
+}
+
+func (c *core) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Core.Enabled(ent.Level) {
+		return ce.AddCore(ent, c)
+	}
+	return ce
+}
+
+func (c *core) Write(ent zapcore.Entry, fields []zapcore.Field) error {
+	enriched := make([]zapcore.Field, 0, len(fields)+2)
+	enriched = append(enriched, zap.String("trace_id", c.traceID), zap.String("span_id", c.spanID))
+	enriched = append(enriched, fields...)
+
+	if err := c.Core.Write(ent, enriched); err != nil {
+		return err
+	}
+
+	if c.span == nil || !c.span.IsRecording() || ent.Level < zapcore.WarnLevel {
+		return nil
+	}
+
+	c.span.AddEvent("log", oteltrace.WithAttributes(
+		attribute.String("log.severity", ent.Level.String()),
+		attribute.String("log.message", ent.Message),
+	))
+	if ent.Level >= zapcore.ErrorLevel {
+		c.span.SetStatus(codes.Error, ent.Message)
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+// Copyright 2025 zampo.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logbridge
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel/codes"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	oteltrace "go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestNewContext_FromContext(t *testing.T) {
+	logger := zap.NewNop()
+	ctx := NewContext(context.Background(), logger)
+
+	if got := FromContext(ctx); got != logger {
+		t.Error("FromContext() did not return the logger bound by NewContext()")
+	}
+}
+
+func TestFromContext_Unbound(t *testing.T) {
+	if got := FromContext(context.Background()); got == nil {
+		t.Error("FromContext() returned nil for an unbound context")
+	}
+}
+
+func TestWrapLogger_NoPanicWithoutSpan(t *testing.T) {
+	logger := WrapLogger(context.Background(), zap.NewNop())
+	logger.Warn("no active span")
+	logger.Error("still no active span")
+}
+
+// capturingSpanExporter records every span handed to it via a
+// SimpleSpanProcessor so the test can inspect events/status after the span
+// ends, instead of just asserting "didn't panic".
+type capturingSpanExporter struct {
+	spans []tracesdk.ReadOnlySpan
+}
+
+func (e *capturingSpanExporter) ExportSpans(ctx context.Context, spans []tracesdk.ReadOnlySpan) error {
+	e.spans = append(e.spans, spans...)
+	return nil
+}
+
+func (e *capturingSpanExporter) Shutdown(ctx context.Context) error { return nil }
+
+// TestWrapLogger_EnrichesAndMirrorsToSpan uses a real, enabled zapcore
+// (zaptest/observer, not zap.NewNop — whose core reports Enabled() as
+// always false and would skip Check/Write entirely) together with a real
+// recording span, to verify the logic WrapLogger/core actually implements:
+// trace_id/span_id enrichment, Warn/Error mirrored as span events, and
+// Error marking the span as codes.Error.
+func TestWrapLogger_EnrichesAndMirrorsToSpan(t *testing.T) {
+	exp := &capturingSpanExporter{}
+	tp := tracesdk.NewTracerProvider(
+		tracesdk.WithSampler(tracesdk.AlwaysSample()),
+		tracesdk.WithSyncer(exp),
+	)
+	defer tp.Shutdown(context.Background())
+
+	ctx, span := tp.Tracer("logbridge_test").Start(context.Background(), "test-span")
+
+	obsCore, logs := observer.New(zapcore.DebugLevel)
+	base := zap.New(obsCore)
+
+	logger := WrapLogger(ctx, base)
+	logger.With(zap.String("component", "test")).Info("component scoped logger still enriches")
+	logger.Warn("something looked off")
+	logger.Error("something broke")
+
+	span.End()
+
+	// trace_id/span_id enrichment
+	sc := oteltrace.SpanFromContext(ctx).SpanContext()
+	for _, entry := range logs.All() {
+		ctxMap := entry.ContextMap()
+		if ctxMap["trace_id"] != sc.TraceID().String() {
+			t.Errorf("log entry %q trace_id = %v, want %v", entry.Message, ctxMap["trace_id"], sc.TraceID().String())
+		}
+		if ctxMap["span_id"] != sc.SpanID().String() {
+			t.Errorf("log entry %q span_id = %v, want %v", entry.Message, ctxMap["span_id"], sc.SpanID().String())
+		}
+	}
+
+	// Warn/Error mirrored as span events, Error sets codes.Error status
+	if len(exp.spans) != 1 {
+		t.Fatalf("expected exactly 1 exported span, got %d", len(exp.spans))
+	}
+	got := exp.spans[0]
+
+	events := got.Events()
+	if len(events) != 2 {
+		t.Fatalf("expected 2 mirrored span events (warn+error), got %d: %+v", len(events), events)
+	}
+	if events[0].Name != "log" || events[1].Name != "log" {
+		t.Errorf("mirrored span events have unexpected names: %q, %q", events[0].Name, events[1].Name)
+	}
+
+	if got.Status().Code != codes.Error {
+		t.Errorf("span status = %v, want codes.Error after an Error-level log", got.Status().Code)
+	}
+	if got.Status().Description != "something broke" {
+		t.Errorf("span status description = %q, want %q", got.Status().Description, "something broke")
+	}
+}
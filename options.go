@@ -0,0 +1,57 @@
+// Copyright 2025 zampo.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// @contact  zampo3380@gmail.com
+
+package trace
+
+import (
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// Option 在 Init 时对 Config 做最后的补充，用于追加处理器、资源属性等
+// 不方便直接放进 Config 字面量的内容
+type Option func(*Config)
+
+// WithSpanProcessor 追加一个自定义 SpanProcessor，例如尾部采样、脱敏或
+// 第二份导出器，会在内置的批量处理器之后依次注册
+func WithSpanProcessor(p tracesdk.SpanProcessor) Option {
+	return func(c *Config) {
+		c.Processors = append(c.Processors, p)
+	}
+}
+
+// WithResourceAttributes 追加额外的资源属性，与 ServiceName/ServiceVersion/
+// Environment 一并写入 Resource
+func WithResourceAttributes(attrs ...attribute.KeyValue) Option {
+	return func(c *Config) {
+		c.ResourceAttributes = append(c.ResourceAttributes, attrs...)
+	}
+}
+
+// WithIDGenerator 替换 TracerProvider 使用的 trace/span ID 生成器
+func WithIDGenerator(g tracesdk.IDGenerator) Option {
+	return func(c *Config) {
+		c.IDGenerator = g
+	}
+}
+
+// WithPropagators 替换全局传播器，默认是 TraceContext + Baggage
+func WithPropagators(p propagation.TextMapPropagator) Option {
+	return func(c *Config) {
+		c.Propagators = p
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright 2025 zampo.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+)
+
+type fakeProcessor struct {
+	shutdownCalled bool
+}
+
+func (p *fakeProcessor) OnStart(ctx context.Context, s tracesdk.ReadWriteSpan) {}
+func (p *fakeProcessor) OnEnd(s tracesdk.ReadOnlySpan)                         {}
+func (p *fakeProcessor) ForceFlush(ctx context.Context) error                  { return nil }
+func (p *fakeProcessor) Shutdown(ctx context.Context) error {
+	p.shutdownCalled = true
+	return nil
+}
+
+func TestWithSpanProcessor(t *testing.T) {
+	fp := &fakeProcessor{}
+	cfg := Config{}
+	WithSpanProcessor(fp)(&cfg)
+
+	if len(cfg.Processors) != 1 || cfg.Processors[0] != fp {
+		t.Errorf("WithSpanProcessor() did not append processor, got %v", cfg.Processors)
+	}
+}
+
+func TestWithResourceAttributes(t *testing.T) {
+	cfg := Config{}
+	WithResourceAttributes(attribute.String("team", "platform"))(&cfg)
+
+	if len(cfg.ResourceAttributes) != 1 || cfg.ResourceAttributes[0].Key != "team" {
+		t.Errorf("WithResourceAttributes() = %v, want one attribute with key %q", cfg.ResourceAttributes, "team")
+	}
+}
+
+// TestShutdown_FlushesUserProcessorsInReverseOrderExactlyOnce drives the real
+// Init/Shutdown path (not the package vars directly) so it also catches a
+// processor's Shutdown being invoked more than once per trace.Shutdown() call.
+func TestShutdown_FlushesUserProcessorsInReverseOrderExactlyOnce(t *testing.T) {
+	var order []int
+	first := &orderTrackingProcessor{id: 1, order: &order}
+	second := &orderTrackingProcessor{id: 2, order: &order}
+
+	cfg := Config{
+		ServiceName:  "shutdown-order-test",
+		OTLEndpoint:  "http://example.invalid:4318",
+		Enabled:      true,
+		ExporterType: ExporterNoop,
+	}
+	if err := Init(cfg, WithSpanProcessor(first), WithSpanProcessor(second)); err != nil {
+		t.Fatalf("Init() unexpected error: %v", err)
+	}
+	defer func() {
+		tracerProvider = nil
+		tracer = nil
+	}()
+
+	if err := Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown() unexpected error: %v", err)
+	}
+	// length 2 (not 4) proves neither processor's Shutdown ran twice
+	if len(order) != 2 || order[0] != 2 || order[1] != 1 {
+		t.Errorf("Shutdown() processor order = %v, want [2 1]", order)
+	}
+}
+
+type orderTrackingProcessor struct {
+	id    int
+	order *[]int
+}
+
+func (p *orderTrackingProcessor) OnStart(ctx context.Context, s tracesdk.ReadWriteSpan) {}
+func (p *orderTrackingProcessor) OnEnd(s tracesdk.ReadOnlySpan)                         {}
+func (p *orderTrackingProcessor) ForceFlush(ctx context.Context) error                  { return nil }
+func (p *orderTrackingProcessor) Shutdown(ctx context.Context) error {
+	*p.order = append(*p.order, p.id)
+	return nil
+}
@@ -0,0 +1,85 @@
+// Copyright 2025 zampo.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// @contact  zampo3380@gmail.com
+
+package trace
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-anyway/framework-log"
+
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.uber.org/zap"
+)
+
+// DefaultReconnectInterval 默认每隔多久重试一次连接真实的 SpanExporter
+const DefaultReconnectInterval = 10 * time.Second
+
+// swappableExporter 包装一个可以在运行期被原子替换的 SpanExporter，配合
+// reconnect 循环使慢启动的 collector 不会永久降级为 noop
+type swappableExporter struct {
+	current atomic.Pointer[tracesdk.SpanExporter]
+}
+
+func newSwappableExporter(initial tracesdk.SpanExporter) *swappableExporter {
+	s := &swappableExporter{}
+	s.current.Store(&initial)
+	return s
+}
+
+func (s *swappableExporter) swap(next tracesdk.SpanExporter) {
+	s.current.Store(&next)
+}
+
+func (s *swappableExporter) ExportSpans(ctx context.Context, spans []tracesdk.ReadOnlySpan) error {
+	return (*s.current.Load()).ExportSpans(ctx, spans)
+}
+
+func (s *swappableExporter) Shutdown(ctx context.Context) error {
+	return (*s.current.Load()).Shutdown(ctx)
+}
+
+// startReconnectLoop 周期性地用 factory 重建 exporter，一旦成功就把它热替换
+// 进 target，然后退出；失败时保持 target 当前的 noopExporter 不变
+func startReconnectLoop(cfg Config, factory SpanExporterFactory, target *swappableExporter, interval time.Duration, stop <-chan struct{}) {
+	if interval <= 0 {
+		interval = DefaultReconnectInterval
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				exp, err := factory(context.Background(), cfg)
+				if err != nil {
+					log.Warn("Reconnect to span exporter failed, keeping noop exporter",
+						zap.String("exporterType", cfg.ExporterType), zap.Error(err))
+					continue
+				}
+				log.Info("Span exporter reconnected", zap.String("exporterType", cfg.ExporterType))
+				target.swap(exp)
+				return
+			}
+		}
+	}()
+}
@@ -0,0 +1,58 @@
+// Copyright 2025 zampo.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func TestSwappableExporter_Swap(t *testing.T) {
+	s := newSwappableExporter(&noopExporter{})
+
+	if err := s.ExportSpans(context.Background(), nil); err != nil {
+		t.Fatalf("ExportSpans() unexpected error before swap: %v", err)
+	}
+
+	s.swap(&flakyExporter{failures: 1, err: errors.New("boom")})
+	if err := s.ExportSpans(context.Background(), nil); err == nil {
+		t.Fatal("ExportSpans() expected error after swap to flaky exporter")
+	}
+}
+
+func TestStartReconnectLoop_SwapsOnSuccess(t *testing.T) {
+	target := newSwappableExporter(&noopExporter{})
+	calls := 0
+	factory := func(ctx context.Context, cfg Config) (tracesdk.SpanExporter, error) {
+		calls++
+		return &flakyExporter{}, nil
+	}
+	stop := make(chan struct{})
+	defer close(stop)
+
+	startReconnectLoop(Config{}, factory, target, 10*time.Millisecond, stop)
+
+	deadline := time.Now().Add(time.Second)
+	for calls == 0 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	if calls == 0 {
+		t.Fatal("startReconnectLoop() never invoked the factory")
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright 2025 zampo.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// @contact  zampo3380@gmail.com
+
+package trace
+
+import (
+	"context"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/go-anyway/framework-log"
+
+	"go.opentelemetry.io/otel"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultRetryInitialInterval = 500 * time.Millisecond
+	defaultRetryMaxInterval     = 30 * time.Second
+	defaultRetryMaxElapsedTime  = 2 * time.Minute
+)
+
+// retryAfterProvider 由可能携带服务端建议重试时间的 error 实现，
+// 用于覆盖默认的指数退避。目前 otlptracegrpc/otlptracehttp 返回的错误都不
+// 实现这个接口——otlptracehttp 把 Retry-After 头部解析逻辑封装在包内私有类型
+// 里，这里识别不到——于是一律回退成我们自己的指数退避；接口保留给未来能携带
+// 结构化重试建议的 exporter 实现
+type retryAfterProvider interface {
+	RetryAfter() (time.Duration, bool)
+}
+
+// retryExporter 包装任意 SpanExporter，在遇到 ResourceExhausted/Unavailable
+// 等可重试错误时按指数退避重试，直到成功或达到 MaxElapsedTime
+type retryExporter struct {
+	next           tracesdk.SpanExporter
+	maxElapsedTime time.Duration
+}
+
+func newRetryExporter(next tracesdk.SpanExporter, maxElapsedTime time.Duration) *retryExporter {
+	if maxElapsedTime <= 0 {
+		maxElapsedTime = defaultRetryMaxElapsedTime
+	}
+	return &retryExporter{next: next, maxElapsedTime: maxElapsedTime}
+}
+
+func (r *retryExporter) ExportSpans(ctx context.Context, spans []tracesdk.ReadOnlySpan) error {
+	deadline := time.Now().Add(r.maxElapsedTime)
+	backoff := defaultRetryInitialInterval
+
+	for {
+		err := r.next.ExportSpans(ctx, spans)
+		if err == nil {
+			return nil
+		}
+		if !isRetryableExportErr(err) || time.Now().After(deadline) {
+			return err
+		}
+
+		wait := backoff
+		if p, ok := err.(retryAfterProvider); ok {
+			if d, ok := p.RetryAfter(); ok {
+				wait = d
+			}
+		}
+
+		log.Warn("Retrying span export after transient error", zap.Error(err), zap.Duration("wait", wait))
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+
+		backoff *= 2
+		if backoff > defaultRetryMaxInterval {
+			backoff = defaultRetryMaxInterval
+		}
+	}
+}
+
+func (r *retryExporter) Shutdown(ctx context.Context) error {
+	return r.next.Shutdown(ctx)
+}
+
+// httpRetryablePattern 匹配 otlptracehttp 内部重试耗尽后透出的错误文案。
+// otlptracehttp 对 429/502/503/504 的响应会包装成包内私有的 retryableError，
+// 它既不实现 GRPCStatus()，其 As() 也只接受同包内的 **retryableError，
+// 从外部无法用类型断言识别，只能匹配它固定输出的 "retry-able request failure" 文案
+var httpRetryablePattern = regexp.MustCompile(`retry-able request failure`)
+
+// isRetryableExportErr 判断错误是否值得重试：
+// gRPC 的 ResourceExhausted/Unavailable 属于瞬时错误；
+// DefaultConfig().ExporterType 是 otlphttp，其错误不携带 gRPC status，
+// 需要额外匹配 otlptracehttp 对 429/502/503/504 的重试文案；
+// 两者都无法识别的错误一律当作不可重试，避免无限堆积 span
+func isRetryableExportErr(err error) bool {
+	if st, ok := status.FromError(err); ok {
+		switch st.Code() {
+		case codes.ResourceExhausted, codes.Unavailable:
+			return true
+		}
+	}
+	return httpRetryablePattern.MatchString(err.Error())
+}
+
+// partialSuccessPattern 匹配 otlptrace 在收到 OTLP ExportTracePartialSuccess
+// 响应时生成的错误信息，形如 "... N spans rejected: reason"
+var partialSuccessPattern = regexp.MustCompile(`(\d+)\s+spans?\s+rejected:?\s*(.*)`)
+
+var installPartialSuccessHandlerOnce sync.Once
+
+// installPartialSuccessHandler 注册一个全局 OTel 错误处理器，把 collector 拒收
+// span 的数量和原因通过 framework-log 在 WARN 级别记录下来。
+// 只在 Init 里调用一次：这个 handler 是 otel 包级别的全局单例，所有 signal
+// 共用，仅仅 import 本包就抢占它会覆盖宿主应用可能已经装好的 handler，
+// 所以和其他副作用一样收敛到 Init 才生效
+func installPartialSuccessHandler() {
+	installPartialSuccessHandlerOnce.Do(func() {
+		otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) {
+			if err == nil {
+				return
+			}
+			if m := partialSuccessPattern.FindStringSubmatch(err.Error()); m != nil {
+				log.Warn("OTLP collector rejected spans",
+					zap.String("rejectedSpans", m[1]), zap.String("reason", m[2]))
+				return
+			}
+			log.Warn("OpenTelemetry internal error", zap.Error(err))
+		}))
+	})
+}
@@ -0,0 +1,209 @@
+// Copyright 2025 zampo.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type flakyExporter struct {
+	failures int
+	err      error
+	calls    int
+}
+
+func (e *flakyExporter) ExportSpans(ctx context.Context, spans []tracesdk.ReadOnlySpan) error {
+	e.calls++
+	if e.calls <= e.failures {
+		return e.err
+	}
+	return nil
+}
+
+func (e *flakyExporter) Shutdown(ctx context.Context) error { return nil }
+
+func TestRetryExporter_RetriesOnResourceExhausted(t *testing.T) {
+	exp := &flakyExporter{failures: 2, err: status.Error(codes.ResourceExhausted, "slow down")}
+	retry := newRetryExporter(exp, time.Second)
+
+	if err := retry.ExportSpans(context.Background(), nil); err != nil {
+		t.Fatalf("ExportSpans() unexpected error: %v", err)
+	}
+	if exp.calls != 3 {
+		t.Errorf("ExportSpans() called underlying exporter %d times, want 3", exp.calls)
+	}
+}
+
+func TestRetryExporter_GivesUpOnNonRetryableError(t *testing.T) {
+	exp := &flakyExporter{failures: 5, err: status.Error(codes.InvalidArgument, "bad request")}
+	retry := newRetryExporter(exp, time.Second)
+
+	err := retry.ExportSpans(context.Background(), nil)
+	if err == nil {
+		t.Fatal("ExportSpans() expected error for non-retryable failure")
+	}
+	if exp.calls != 1 {
+		t.Errorf("ExportSpans() called underlying exporter %d times, want 1", exp.calls)
+	}
+}
+
+func TestIsRetryableExportErr(t *testing.T) {
+	if !isRetryableExportErr(status.Error(codes.Unavailable, "down")) {
+		t.Error("isRetryableExportErr(Unavailable) = false, want true")
+	}
+	if isRetryableExportErr(errors.New("plain error")) {
+		t.Error("isRetryableExportErr(plain error) = true, want false")
+	}
+}
+
+// TestIsRetryableExportErr_RealOTLPHTTPError drives an actual otlptracehttp
+// exporter against a local server returning 503, instead of a hand-built
+// status.Error, to catch the case where isRetryableExportErr never
+// recognizes errors from the default ExporterOTLPHTTP transport (which
+// don't carry a gRPC status).
+func TestIsRetryableExportErr_RealOTLPHTTPError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	exp, err := otlptracehttp.New(context.Background(),
+		otlptracehttp.WithEndpointURL(srv.URL),
+		otlptracehttp.WithRetry(otlptracehttp.RetryConfig{Enabled: false}))
+	if err != nil {
+		t.Fatalf("otlptracehttp.New() unexpected error: %v", err)
+	}
+	defer exp.Shutdown(context.Background())
+
+	exportErr := exp.ExportSpans(context.Background(), recordTestSpans(t))
+	if exportErr == nil {
+		t.Fatal("ExportSpans() expected error from a 503 response, got nil")
+	}
+	if !isRetryableExportErr(exportErr) {
+		t.Errorf("isRetryableExportErr(%v) = false, want true for a real otlptracehttp 503 error", exportErr)
+	}
+}
+
+// recordTestSpans returns a single recorded, read-only span so ExportSpans
+// actually performs an HTTP round trip instead of short-circuiting on an
+// empty batch.
+func recordTestSpans(t *testing.T) []tracesdk.ReadOnlySpan {
+	t.Helper()
+	tp := tracesdk.NewTracerProvider(tracesdk.WithSampler(tracesdk.AlwaysSample()))
+	defer tp.Shutdown(context.Background())
+
+	var spans []tracesdk.ReadOnlySpan
+	recorder := tracesdk.NewSimpleSpanProcessor(stubSpanExporter{out: &spans})
+	tp.RegisterSpanProcessor(recorder)
+
+	_, span := tp.Tracer("retry_test").Start(context.Background(), "test-span")
+	span.End()
+
+	return spans
+}
+
+// stubSpanExporter captures the spans handed to it instead of sending them
+// anywhere, so recordTestSpans can produce real tracesdk.ReadOnlySpan values.
+type stubSpanExporter struct {
+	out *[]tracesdk.ReadOnlySpan
+}
+
+func (e stubSpanExporter) ExportSpans(ctx context.Context, spans []tracesdk.ReadOnlySpan) error {
+	*e.out = append(*e.out, spans...)
+	return nil
+}
+
+func (e stubSpanExporter) Shutdown(ctx context.Context) error { return nil }
+
+// TestIsRetryableExportErr_RealOTLPHTTPError_NonRetryable checks that a
+// non-retryable HTTP status (not one of 429/502/503/504) is still rejected.
+func TestIsRetryableExportErr_RealOTLPHTTPError_NonRetryable(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer srv.Close()
+
+	exp, err := otlptracehttp.New(context.Background(),
+		otlptracehttp.WithEndpointURL(srv.URL),
+		otlptracehttp.WithRetry(otlptracehttp.RetryConfig{Enabled: false}))
+	if err != nil {
+		t.Fatalf("otlptracehttp.New() unexpected error: %v", err)
+	}
+	defer exp.Shutdown(context.Background())
+
+	exportErr := exp.ExportSpans(context.Background(), recordTestSpans(t))
+	if exportErr == nil {
+		t.Fatal("ExportSpans() expected error from a 400 response, got nil")
+	}
+	if isRetryableExportErr(exportErr) {
+		t.Errorf("isRetryableExportErr(%v) = true, want false for a non-retryable HTTP status", exportErr)
+	}
+	if strings.Contains(exportErr.Error(), "retry-able request failure") {
+		t.Fatalf("test invariant broken: a 400 response should not produce otlptracehttp's retryable error text, got %v", exportErr)
+	}
+}
+
+func TestPartialSuccessPattern_Matches(t *testing.T) {
+	m := partialSuccessPattern.FindStringSubmatch("OTLP partial success: 3 spans rejected: buffer full")
+	if m == nil || m[1] != "3" {
+		t.Errorf("partialSuccessPattern did not match rejected span count, got %v", m)
+	}
+}
+
+// TestInit_RegistersPartialSuccessErrorHandler drives installPartialSuccessHandler
+// through Init and otel.Handle — the global handler it actually registers —
+// instead of only unit-testing partialSuccessPattern in isolation. It resets
+// installPartialSuccessHandlerOnce so the sync.Once fires regardless of
+// whatever earlier test already called Init with tracing enabled.
+func TestInit_RegistersPartialSuccessErrorHandler(t *testing.T) {
+	installPartialSuccessHandlerOnce = sync.Once{}
+
+	sentinelCalled := false
+	otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) {
+		sentinelCalled = true
+	}))
+
+	cfg := Config{
+		Enabled:      true,
+		ExporterType: ExporterNoop,
+	}
+	if err := Init(cfg); err != nil {
+		t.Fatalf("Init() unexpected error: %v", err)
+	}
+	defer func() {
+		Shutdown(context.Background())
+		tracerProvider = nil
+		tracer = nil
+	}()
+
+	otel.Handle(errors.New("OTLP partial success: 2 spans rejected: buffer full"))
+
+	if sentinelCalled {
+		t.Error("Init() did not register its own OTel error handler: a previously installed handler still fired")
+	}
+}
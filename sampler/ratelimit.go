@@ -0,0 +1,72 @@
+// Copyright 2025 zampo.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// @contact  zampo3380@gmail.com
+
+package sampler
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// tokenBucketSampler 实现 Jaeger 的 RATE_LIMITING 策略：按固定速率补充令牌，
+// 每个根 span 消耗一个令牌，令牌不足时丢弃
+type tokenBucketSampler struct {
+	mu           sync.Mutex
+	maxPerSecond float64
+	tokens       float64
+	lastRefill   time.Time
+}
+
+func newTokenBucketSampler(maxTracesPerSecond float64) *tokenBucketSampler {
+	return &tokenBucketSampler{
+		maxPerSecond: maxTracesPerSecond,
+		tokens:       maxTracesPerSecond,
+		lastRefill:   time.Now(),
+	}
+}
+
+func (s *tokenBucketSampler) ShouldSample(p tracesdk.SamplingParameters) tracesdk.SamplingResult {
+	decision := tracesdk.Drop
+	if s.takeToken() {
+		decision = tracesdk.RecordAndSample
+	}
+	return tracesdk.SamplingResult{Decision: decision}
+}
+
+func (s *tokenBucketSampler) takeToken() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	s.tokens += now.Sub(s.lastRefill).Seconds() * s.maxPerSecond
+	if s.tokens > s.maxPerSecond {
+		s.tokens = s.maxPerSecond
+	}
+	s.lastRefill = now
+
+	if s.tokens < 1 {
+		return false
+	}
+	s.tokens--
+	return true
+}
+
+func (s *tokenBucketSampler) Description() string {
+	return fmt.Sprintf("RateLimitingSampler{maxTracesPerSecond=%v}", s.maxPerSecond)
+}
@@ -0,0 +1,237 @@
+// Copyright 2025 zampo.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// @contact  zampo3380@gmail.com
+
+// Package sampler 提供从远端拉取采样策略的 tracesdk.Sampler 实现，
+// 兼容 Jaeger 的 /sampling?service=<name> 接口
+package sampler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-anyway/framework-log"
+
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.uber.org/zap"
+)
+
+// DefaultRefreshInterval 默认每隔多久重新拉取一次采样策略
+const DefaultRefreshInterval = 60 * time.Second
+
+// Fetcher 拉取指定服务的采样策略原始 JSON，默认实现基于 http.Client，
+// 测试可通过 WithSamplingStrategyFetcher 注入假实现
+type Fetcher interface {
+	FetchStrategy(ctx context.Context, endpoint, serviceName string) ([]byte, error)
+}
+
+// httpFetcher 是 Fetcher 的默认实现，请求 Jaeger 兼容的 /sampling 接口
+type httpFetcher struct {
+	client *http.Client
+}
+
+func (f *httpFetcher) FetchStrategy(ctx context.Context, endpoint, serviceName string) ([]byte, error) {
+	reqURL := fmt.Sprintf("%s/sampling?service=%s", strings.TrimRight(endpoint, "/"), url.QueryEscape(serviceName))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("sampling strategy request failed with status %d", resp.StatusCode)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// RemoteOption 用于配置 NewRemoteSampler 创建的采样器
+type RemoteOption func(*remoteSampler)
+
+// WithRefreshInterval 设置拉取采样策略的周期，默认 DefaultRefreshInterval
+func WithRefreshInterval(d time.Duration) RemoteOption {
+	return func(s *remoteSampler) {
+		s.refreshInterval = d
+	}
+}
+
+// WithSamplingStrategyFetcher 替换拉取采样策略的实现，便于测试注入假数据
+func WithSamplingStrategyFetcher(f Fetcher) RemoteOption {
+	return func(s *remoteSampler) {
+		s.fetcher = f
+	}
+}
+
+// strategyResponse 对应 Jaeger /sampling 接口返回的 JSON 结构
+type strategyResponse struct {
+	StrategyType          string                 `json:"strategyType"`
+	ProbabilisticSampling *probabilisticStrategy `json:"probabilisticSampling,omitempty"`
+	RateLimitingSampling  *rateLimitingStrategy  `json:"rateLimitingSampling,omitempty"`
+}
+
+type probabilisticStrategy struct {
+	SamplingRate float64 `json:"samplingRate"`
+}
+
+type rateLimitingStrategy struct {
+	MaxTracesPerSecond float64 `json:"maxTracesPerSecond"`
+}
+
+// remoteSampler 持有当前生效的 tracesdk.Sampler，后台 goroutine 定期向
+// endpoint 拉取策略并原子替换；拉取失败时保留上一次生效的采样器
+type remoteSampler struct {
+	serviceName     string
+	endpoint        string
+	refreshInterval time.Duration
+	fetcher         Fetcher
+
+	current atomic.Pointer[tracesdk.Sampler]
+	stopCh  chan struct{}
+}
+
+// RemoteSampler 是 NewRemoteSampler 的返回类型：既是一个 tracesdk.Sampler
+// （可以直接传给 tracesdk.WithSampler），也实现了 io.Closer，调用 Close 会停止
+// 后台的策略刷新 goroutine
+type RemoteSampler struct {
+	root      *remoteSampler
+	wrapped   tracesdk.Sampler
+	closeOnce sync.Once
+}
+
+// ShouldSample 委托给 ParentBased 包装后的采样器
+func (s *RemoteSampler) ShouldSample(p tracesdk.SamplingParameters) tracesdk.SamplingResult {
+	return s.wrapped.ShouldSample(p)
+}
+
+// Description 返回采样器描述，用于日志/调试
+func (s *RemoteSampler) Description() string {
+	return s.wrapped.Description()
+}
+
+// Close 停止后台的策略刷新 goroutine，可安全多次调用
+func (s *RemoteSampler) Close() error {
+	s.closeOnce.Do(func() {
+		close(s.root.stopCh)
+	})
+	return nil
+}
+
+// NewRemoteSampler 创建一个从 endpoint 周期性拉取采样策略的 Sampler，
+// 返回值已经用 ParentBased 包装，子 span 会继承父 span 的采样决策。
+// 返回值同时实现 io.Closer，调用方必须在不再需要该采样器时调用 Close，
+// 否则后台刷新 goroutine 会一直运行下去
+func NewRemoteSampler(serviceName, endpoint string, opts ...RemoteOption) *RemoteSampler {
+	s := &remoteSampler{
+		serviceName:     serviceName,
+		endpoint:        endpoint,
+		refreshInterval: DefaultRefreshInterval,
+		fetcher:         &httpFetcher{client: http.DefaultClient},
+		stopCh:          make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	fallback := tracesdk.Sampler(tracesdk.TraceIDRatioBased(0.001))
+	s.current.Store(&fallback)
+
+	go s.refreshLoop()
+
+	return &RemoteSampler{root: s, wrapped: tracesdk.ParentBased(s)}
+}
+
+func (s *remoteSampler) refreshLoop() {
+	s.refresh()
+
+	ticker := time.NewTicker(s.refreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.refresh()
+		case <-s.stopCh:
+			return
+		}
+	}
+}
+
+func (s *remoteSampler) refresh() {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	body, err := s.fetcher.FetchStrategy(ctx, s.endpoint, s.serviceName)
+	if err != nil {
+		log.Warn("Failed to fetch remote sampling strategy, keeping previous sampler",
+			zap.String("service", s.serviceName), zap.Error(err))
+		return
+	}
+
+	var resp strategyResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		log.Warn("Failed to parse remote sampling strategy response, keeping previous sampler",
+			zap.String("service", s.serviceName), zap.Error(err))
+		return
+	}
+
+	next, err := samplerFromStrategy(resp)
+	if err != nil {
+		log.Warn("Unsupported remote sampling strategy, keeping previous sampler",
+			zap.String("service", s.serviceName), zap.String("strategyType", resp.StrategyType), zap.Error(err))
+		return
+	}
+
+	s.current.Store(&next)
+}
+
+func samplerFromStrategy(resp strategyResponse) (tracesdk.Sampler, error) {
+	switch resp.StrategyType {
+	case "PROBABILISTIC":
+		if resp.ProbabilisticSampling == nil {
+			return nil, fmt.Errorf("strategyType PROBABILISTIC missing probabilisticSampling")
+		}
+		return tracesdk.TraceIDRatioBased(resp.ProbabilisticSampling.SamplingRate), nil
+	case "RATE_LIMITING":
+		if resp.RateLimitingSampling == nil {
+			return nil, fmt.Errorf("strategyType RATE_LIMITING missing rateLimitingSampling")
+		}
+		return newTokenBucketSampler(resp.RateLimitingSampling.MaxTracesPerSecond), nil
+	default:
+		return nil, fmt.Errorf("unknown strategyType %q", resp.StrategyType)
+	}
+}
+
+// ShouldSample 委托给当前生效的采样器，保证并发读取时拿到的是完整替换后的指针
+func (s *remoteSampler) ShouldSample(p tracesdk.SamplingParameters) tracesdk.SamplingResult {
+	cur := *s.current.Load()
+	return cur.ShouldSample(p)
+}
+
+// Description 返回采样器描述，用于日志/调试
+func (s *remoteSampler) Description() string {
+	return fmt.Sprintf("RemoteSampler{service=%s,endpoint=%s}", s.serviceName, s.endpoint)
+}
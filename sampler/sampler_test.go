@@ -0,0 +1,148 @@
+// Copyright 2025 zampo.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sampler
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type fakeFetcher struct {
+	body []byte
+	err  error
+}
+
+func (f *fakeFetcher) FetchStrategy(ctx context.Context, endpoint, serviceName string) ([]byte, error) {
+	return f.body, f.err
+}
+
+// TestSamplerFromStrategy_Probabilistic drives ShouldSample over many trace
+// IDs and checks the sampled fraction lands close to the configured ratio.
+// tracesdk.TraceIDRatioBased is a constructor, not an exported type, so the
+// sampler it returns cannot be asserted to from outside the sdk/trace
+// package — behavior is all we can (and should) verify here.
+func TestSamplerFromStrategy_Probabilistic(t *testing.T) {
+	const ratio = 0.25
+	resp := strategyResponse{
+		StrategyType:          "PROBABILISTIC",
+		ProbabilisticSampling: &probabilisticStrategy{SamplingRate: ratio},
+	}
+
+	s, err := samplerFromStrategy(resp)
+	if err != nil {
+		t.Fatalf("samplerFromStrategy() unexpected error: %v", err)
+	}
+
+	const trials = 10000
+	var sampled int
+	for i := 0; i < trials; i++ {
+		result := s.ShouldSample(tracesdk.SamplingParameters{TraceID: traceIDFromInt(uint64(i))})
+		if result.Decision != tracesdk.Drop {
+			sampled++
+		}
+	}
+
+	got := float64(sampled) / trials
+	if diff := got - ratio; diff < -0.05 || diff > 0.05 {
+		t.Errorf("samplerFromStrategy() sampled fraction = %.3f, want close to %.2f", got, ratio)
+	}
+}
+
+// traceIDFromInt builds a deterministic trace.TraceID for trial n, spreading
+// the low 8 bytes (the ones TraceIDRatioBased hashes) across the full 64-bit
+// range via Fibonacci hashing so the sampled fraction converges to the ratio.
+func traceIDFromInt(n uint64) trace.TraceID {
+	var id trace.TraceID
+	binary.BigEndian.PutUint64(id[8:], n*0x9E3779B97F4A7C15)
+	return id
+}
+
+func TestSamplerFromStrategy_RateLimiting(t *testing.T) {
+	resp := strategyResponse{
+		StrategyType:         "RATE_LIMITING",
+		RateLimitingSampling: &rateLimitingStrategy{MaxTracesPerSecond: 5},
+	}
+
+	s, err := samplerFromStrategy(resp)
+	if err != nil {
+		t.Fatalf("samplerFromStrategy() unexpected error: %v", err)
+	}
+	if _, ok := s.(*tokenBucketSampler); !ok {
+		t.Errorf("samplerFromStrategy() = %T, want *tokenBucketSampler", s)
+	}
+}
+
+func TestSamplerFromStrategy_Unknown(t *testing.T) {
+	if _, err := samplerFromStrategy(strategyResponse{StrategyType: "BOGUS"}); err == nil {
+		t.Error("samplerFromStrategy() expected error for unknown strategyType")
+	}
+}
+
+func TestRemoteSampler_KeepsPreviousSamplerOnFetchFailure(t *testing.T) {
+	fetcher := &fakeFetcher{err: context.DeadlineExceeded}
+
+	s := NewRemoteSampler("svc", "http://example.invalid",
+		WithSamplingStrategyFetcher(fetcher),
+		WithRefreshInterval(time.Hour))
+	defer s.Close()
+
+	if s == nil {
+		t.Fatal("NewRemoteSampler() returned nil")
+	}
+}
+
+// TestRemoteSampler_CloseStopsRefreshLoop asserts Close actually terminates
+// the background refresh goroutine instead of leaking it forever, and that
+// it is safe to call more than once.
+func TestRemoteSampler_CloseStopsRefreshLoop(t *testing.T) {
+	fetcher := &fakeFetcher{body: []byte(`{"strategyType":"PROBABILISTIC","probabilisticSampling":{"samplingRate":1}}`)}
+
+	s := NewRemoteSampler("svc", "http://example.invalid",
+		WithSamplingStrategyFetcher(fetcher),
+		WithRefreshInterval(time.Millisecond))
+
+	if err := s.Close(); err != nil {
+		t.Fatalf("Close() unexpected error: %v", err)
+	}
+
+	select {
+	case <-s.root.stopCh:
+	default:
+		t.Error("Close() did not close stopCh")
+	}
+
+	if err := s.Close(); err != nil {
+		t.Errorf("second Close() unexpected error: %v", err)
+	}
+}
+
+func TestTokenBucketSampler_DropsWhenExhausted(t *testing.T) {
+	s := newTokenBucketSampler(1)
+
+	first := s.ShouldSample(tracesdk.SamplingParameters{})
+	if first.Decision != tracesdk.RecordAndSample {
+		t.Errorf("first ShouldSample() = %v, want RecordAndSample", first.Decision)
+	}
+
+	second := s.ShouldSample(tracesdk.SamplingParameters{})
+	if second.Decision != tracesdk.Drop {
+		t.Errorf("second ShouldSample() = %v, want Drop", second.Decision)
+	}
+}
@@ -18,14 +18,16 @@ package trace
 
 import (
 	"context"
-	"fmt"
+	"crypto/tls"
+	"io"
 	"strings"
 	"time"
 
 	"github.com/go-anyway/framework-log"
+	"github.com/go-anyway/framework-trace/sampler"
 
 	"go.opentelemetry.io/otel"
-	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/propagation"
 	"go.opentelemetry.io/otel/sdk/resource"
 	tracesdk "go.opentelemetry.io/otel/sdk/trace"
@@ -39,6 +41,11 @@ var (
 	tracerProvider *tracesdk.TracerProvider
 	// Tracer 全局追踪器
 	tracer trace.Tracer
+	// reconnectStop 用于在 Shutdown 时停止后台的 exporter 重连 goroutine
+	reconnectStop chan struct{}
+	// samplerCloser 在 SamplerType 为 "remote" 时持有远端采样器，
+	// 用于在 Shutdown 时停止其后台的策略刷新 goroutine
+	samplerCloser io.Closer
 )
 
 // Config 追踪配置
@@ -49,6 +56,43 @@ type Config struct {
 	OTLEndpoint    string // OTLP endpoint, 例如: http://localhost:4318/v1/traces (HTTP) 或 http://localhost:4317 (gRPC)
 	Enabled        bool
 	SampleRate     float64 // 采样率 0.0-1.0
+
+	// ExporterType 导出器类型: otlphttp, otlpgrpc, stdout, zipkin, noop
+	// 留空时按 OTEL_TRACES_EXPORTER / OTEL_EXPORTER_OTLP_PROTOCOL 自动选择，详见 resolveExporterType
+	ExporterType string
+	// OTLPHeaders 随 OTLP 请求发送的自定义 header，例如鉴权 token
+	OTLPHeaders map[string]string
+	// TLSConfig gRPC/HTTPS exporter 使用的 TLS 配置；为 nil 时回退到 Insecure
+	TLSConfig *tls.Config
+	// Insecure 在 TLSConfig 为空时是否使用明文传输，默认 true 以兼容旧行为
+	Insecure bool
+
+	// Processors 用户自定义的 SpanProcessor，会在内置的批量处理器之后依次注册，
+	// 也可以通过 WithSpanProcessor 追加
+	Processors []tracesdk.SpanProcessor
+	// ResourceAttributes 额外的资源属性，也可以通过 WithResourceAttributes 追加
+	ResourceAttributes []attribute.KeyValue
+	// IDGenerator 自定义 trace/span ID 生成器，为空时使用 SDK 默认实现
+	IDGenerator tracesdk.IDGenerator
+	// Propagators 自定义全局传播器，为空时使用 TraceContext + Baggage
+	Propagators propagation.TextMapPropagator
+
+	// SamplerType 采样器类型，留空或 "ratio" 时使用 SampleRate 对应的
+	// TraceIDRatioBased；设为 "remote" 时改为从 SamplerEndpoint 周期拉取
+	// Jaeger 兼容的采样策略，详见 sampler 子包
+	SamplerType string
+	// SamplerEndpoint SamplerType 为 "remote" 时的 Jaeger 兼容采样策略端点
+	SamplerEndpoint string
+	// SamplerRefreshInterval SamplerType 为 "remote" 时拉取采样策略的周期，
+	// 为零值时使用 sampler.DefaultRefreshInterval
+	SamplerRefreshInterval time.Duration
+
+	// MaxElapsedTime retryExporter 重试单次导出的最长耗时，为零值时使用
+	// defaultRetryMaxElapsedTime
+	MaxElapsedTime time.Duration
+	// ReconnectInterval exporter 初次创建失败后，重试连接的周期，为零值时
+	// 使用 DefaultReconnectInterval
+	ReconnectInterval time.Duration
 }
 
 // DefaultConfig 返回默认配置
@@ -60,6 +104,8 @@ func DefaultConfig() Config {
 		OTLEndpoint:    "http://localhost:4318/v1/traces", // OTLP HTTP endpoint (Jaeger 从 v1.35.0 开始支持)
 		Enabled:        true,
 		SampleRate:     1.0,
+		ExporterType:   ExporterOTLPHTTP,
+		Insecure:       true,
 	}
 }
 
@@ -71,82 +117,115 @@ func FromAppConfig(appCfg interface{}) Config {
 }
 
 // Init 初始化 OpenTelemetry 追踪
-func Init(cfg Config) error {
+func Init(cfg Config, opts ...Option) error {
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
 	if !cfg.Enabled {
 		return nil
 	}
 
-	// 验证 OTLP 端点配置
-	if cfg.OTLEndpoint == "" {
-		return fmt.Errorf("otlp endpoint is required when tracing is enabled")
-	}
+	installPartialSuccessHandler()
 
 	// 创建资源
 	// 注意：不使用 resource.Default() 避免 Schema URL 冲突
 	// 直接创建 resource 并指定统一的 Schema URL
-	res := resource.NewWithAttributes(
-		semconv.SchemaURL,
+	attrs := append([]attribute.KeyValue{
 		semconv.ServiceName(cfg.ServiceName),
 		semconv.ServiceVersion(cfg.ServiceVersion),
 		semconv.DeploymentEnvironment(cfg.Environment),
-	)
+	}, cfg.ResourceAttributes...)
+	res := resource.NewWithAttributes(semconv.SchemaURL, attrs...)
 
-	// 创建 OTLP HTTP exporter
+	// 根据 cfg.ExporterType / OTEL_TRACES_EXPORTER 等环境变量选择 exporter 工厂
 	// 注意：如果端点不存在或连接失败，使用 noopExporter 避免内存泄漏
-	// OTLP HTTP endpoint 格式: host:port (例如: localhost:4318)
-	// 路径 /v1/traces 会自动添加
-	var exp tracesdk.SpanExporter
-	otlpExp, err := otlptracehttp.New(context.Background(),
-		otlptracehttp.WithEndpoint(extractHostPort(cfg.OTLEndpoint)),
-		otlptracehttp.WithInsecure(), // 如果使用 HTTPS，需要配置 TLS
-	)
+	exporterType := resolveExporterType(cfg)
+	factory, ok := lookupExporter(exporterType)
+	if !ok {
+		log.Warn("Unknown exporter type, falling back to noop exporter", zap.String("exporterType", exporterType))
+		factory = newNoopSpanExporter
+	}
+
+	exp, err := factory(context.Background(), cfg)
 	if err != nil {
 		// 如果创建 exporter 失败，不阻止启动，但记录错误
-		// 使用 NoopExporter 避免内存泄漏
-		log.Warn("Failed to create OTLP exporter, using noop exporter", zap.Error(err))
-		exp = &noopExporter{}
-	} else {
-		exp = otlpExp
+		// 用 noopExporter 占位避免内存泄漏，同时启动后台 goroutine 定期重连，
+		// 一旦 collector 恢复就热替换成真正的 exporter
+		log.Warn("Failed to create span exporter, using noop exporter", zap.Error(err))
+		swappable := newSwappableExporter(&noopExporter{})
+		reconnectStop = make(chan struct{})
+		startReconnectLoop(cfg, factory, swappable, cfg.ReconnectInterval, reconnectStop)
+		exp = swappable
 	}
 
-	// 限制采样率范围
-	sampleRate := cfg.SampleRate
-	if sampleRate < 0 {
-		sampleRate = 0
-	}
-	if sampleRate > 1 {
-		sampleRate = 1
-	}
+	// 包一层重试装饰器，对 ResourceExhausted/Unavailable 等瞬时错误做指数退避
+	exp = newRetryExporter(exp, cfg.MaxElapsedTime)
 
 	// 创建采样器
-	// 使用 ParentBased sampler 来继承父 span 的采样决策
-	// 对于根 span（没有父 span），使用 TraceIDRatioBased 进行采样决策
-	// 这样可以确保：
+	// 使用 ParentBased sampler 来继承父 span 的采样决策：
 	// 1. 网关层决定采样后，下游服务会继承采样决策，不会重新采样
 	// 2. 异步消息中的 trace context 也会继承采样决策
 	// 3. 只有根 span（网关层）才会根据采样率进行采样决策
-	sampler := tracesdk.ParentBased(tracesdk.TraceIDRatioBased(sampleRate))
-
-	// 创建 TracerProvider，使用批量导出器，设置合理的缓冲区大小
-	// 设置最大队列大小防止内存爆炸，即使端点不存在也不会无限增长
-	tp := tracesdk.NewTracerProvider(
-		tracesdk.WithBatcher(exp,
-			tracesdk.WithBatchTimeout(5*time.Second), // 5秒批量导出
-			tracesdk.WithMaxExportBatchSize(512),     // 最大批量大小
-			tracesdk.WithMaxQueueSize(2048),          // 最大队列大小，防止内存爆炸
-		),
-		tracesdk.WithResource(res),
-		tracesdk.WithSampler(sampler),
+	var traceSampler tracesdk.Sampler
+	if cfg.SamplerType == "remote" {
+		var samplerOpts []sampler.RemoteOption
+		if cfg.SamplerRefreshInterval > 0 {
+			samplerOpts = append(samplerOpts, sampler.WithRefreshInterval(cfg.SamplerRefreshInterval))
+		}
+		remoteSampler := sampler.NewRemoteSampler(cfg.ServiceName, cfg.SamplerEndpoint, samplerOpts...)
+		samplerCloser = remoteSampler
+		traceSampler = remoteSampler
+	} else {
+		// 限制采样率范围
+		sampleRate := cfg.SampleRate
+		if sampleRate < 0 {
+			sampleRate = 0
+		}
+		if sampleRate > 1 {
+			sampleRate = 1
+		}
+		traceSampler = tracesdk.ParentBased(tracesdk.TraceIDRatioBased(sampleRate))
+	}
+
+	// 内置的批量处理器放在最前面，用户通过 Config.Processors / WithSpanProcessor
+	// 追加的处理器（尾部采样、脱敏、调试用的文件导出器等）依次排在其后
+	batchProcessor := tracesdk.NewBatchSpanProcessor(exp,
+		tracesdk.WithBatchTimeout(5*time.Second), // 5秒批量导出
+		tracesdk.WithMaxExportBatchSize(512),     // 最大批量大小
+		tracesdk.WithMaxQueueSize(2048),          // 最大队列大小，防止内存爆炸
 	)
+	procs := append([]tracesdk.SpanProcessor{batchProcessor}, cfg.Processors...)
+
+	tpOpts := []tracesdk.TracerProviderOption{
+		tracesdk.WithResource(res),
+		tracesdk.WithSampler(traceSampler),
+	}
+	// TracerProvider.Shutdown 按注册顺序逐个关闭 processor，这里反序注册，
+	// 使得用户追加的处理器先于内置批量处理器关闭——同时这是 processor 关闭的唯一入口，
+	// 避免再手动调用一遍导致用户处理器的 Shutdown 被执行两次
+	for i := len(procs) - 1; i >= 0; i-- {
+		tpOpts = append(tpOpts, tracesdk.WithSpanProcessor(procs[i]))
+	}
+	if cfg.IDGenerator != nil {
+		tpOpts = append(tpOpts, tracesdk.WithIDGenerator(cfg.IDGenerator))
+	}
+
+	// 创建 TracerProvider
+	tp := tracesdk.NewTracerProvider(tpOpts...)
 
 	// 设置为全局 TracerProvider
 	otel.SetTracerProvider(tp)
 
-	// 设置全局传播器
-	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
-		propagation.TraceContext{},
-		propagation.Baggage{},
-	))
+	// 设置全局传播器，默认 TraceContext + Baggage，可通过 WithPropagators 替换
+	propagator := cfg.Propagators
+	if propagator == nil {
+		propagator = propagation.NewCompositeTextMapPropagator(
+			propagation.TraceContext{},
+			propagation.Baggage{},
+		)
+	}
+	otel.SetTextMapPropagator(propagator)
 
 	tracerProvider = tp
 	tracer = tp.Tracer(cfg.ServiceName)
@@ -168,7 +247,23 @@ func (e *noopExporter) Shutdown(ctx context.Context) error {
 }
 
 // Shutdown 关闭追踪器
+// TracerProvider.Shutdown 会按注册顺序关闭每个 SpanProcessor 恰好一次
+// （Init 已经反序注册，所以用户追加的处理器先于内置批量处理器关闭），
+// 这里不再手动遍历 processor，避免同一个 processor 的 Shutdown 被调用两次
 func Shutdown(ctx context.Context) error {
+	if reconnectStop != nil {
+		close(reconnectStop)
+		reconnectStop = nil
+	}
+
+	if samplerCloser != nil {
+		// 停止远端采样器的后台策略刷新 goroutine，避免 Init/Shutdown 多次调用导致泄漏
+		if err := samplerCloser.Close(); err != nil {
+			log.Warn("Failed to close remote sampler", zap.Error(err))
+		}
+		samplerCloser = nil
+	}
+
 	if tracerProvider != nil {
 		return tracerProvider.Shutdown(ctx)
 	}
@@ -64,19 +64,61 @@ func TestInit_Disabled(t *testing.T) {
 	}
 }
 
-func TestInit_EmptyEndpoint(t *testing.T) {
+// TestInit_EmptyEndpoint_FallsBackToNoop covers the default exporter
+// (otlphttp), which does need an endpoint: Init no longer rejects this
+// upfront, the endpoint requirement now lives in newOTLPHTTPExporter, so a
+// missing endpoint is handled like any other exporter creation failure —
+// Init still succeeds, swapping in a noop exporter and a reconnect loop.
+func TestInit_EmptyEndpoint_FallsBackToNoop(t *testing.T) {
 	cfg := Config{
 		Enabled:     true,
 		OTLEndpoint: "",
 	}
 
-	err := Init(cfg)
-	if err == nil {
-		t.Error("Init(empty endpoint) expected error, got nil")
+	if err := Init(cfg); err != nil {
+		t.Errorf("Init(empty endpoint) unexpected error: %v", err)
+	}
+	defer func() {
+		Shutdown(context.Background())
+		tracerProvider = nil
+		tracer = nil
+	}()
+}
+
+// TestInit_StdoutExporterWithoutEndpoint and TestInit_NoopExporterWithoutEndpoint
+// cover the scenario the request is actually about: "stdout" for local
+// debugging and "noop" to disable output, neither of which has an endpoint
+// concept, must work without cfg.OTLEndpoint.
+func TestInit_StdoutExporterWithoutEndpoint(t *testing.T) {
+	cfg := Config{
+		Enabled:      true,
+		ExporterType: ExporterStdout,
+	}
+
+	if err := Init(cfg); err != nil {
+		t.Errorf("Init(stdout, no endpoint) unexpected error: %v", err)
+	}
+	defer func() {
+		Shutdown(context.Background())
+		tracerProvider = nil
+		tracer = nil
+	}()
+}
+
+func TestInit_NoopExporterWithoutEndpoint(t *testing.T) {
+	cfg := Config{
+		Enabled:      true,
+		ExporterType: ExporterNoop,
 	}
-	if err.Error() != "otlp endpoint is required when tracing is enabled" {
-		t.Errorf("Init(empty endpoint) error message = %q, want %q", err.Error(), "otlp endpoint is required when tracing is enabled")
+
+	if err := Init(cfg); err != nil {
+		t.Errorf("Init(noop, no endpoint) unexpected error: %v", err)
 	}
+	defer func() {
+		Shutdown(context.Background())
+		tracerProvider = nil
+		tracer = nil
+	}()
 }
 
 func TestExtractHostPort_WithHTTP(t *testing.T) {